@@ -19,6 +19,7 @@ package scheduling
 import (
 	"fmt"
 	"reflect"
+	"strings"
 	"time"
 
 	"k8s.io/api/core/v1"
@@ -199,6 +200,95 @@ var _ = SIGDescribe("LimitRange", func() {
 		framework.ExpectNoError(err)
 	})
 
+	// This exercises the LimitRange admission plugin's existing per-resource
+	// MaxLimitRequestRatio enforcement (MaxLimitRequestRatio is itself a
+	// ResourceList, so CPU/memory/ephemeral-storage are already independent
+	// entries); no new admission plumbing is added by this test.
+	ginkgo.It("should enforce MaxLimitRequestRatio independently per resource", func() {
+		ginkgo.By("Creating a LimitRange with a CPU ratio of 2 and a memory ratio of 3")
+		min := getResourceList("", "", "")
+		max := getResourceList("", "", "")
+		defaultLimit := getResourceList("", "", "")
+		defaultRequest := getResourceList("", "", "")
+		maxLimitRequestRatio := getResourceList("2", "3", "")
+		limitRange := newLimitRange("limit-range-ratio", v1.LimitTypeContainer,
+			min, max,
+			defaultLimit, defaultRequest,
+			maxLimitRequestRatio)
+		limitRange, err := f.ClientSet.CoreV1().LimitRanges(f.Namespace.Name).Create(limitRange)
+		framework.ExpectNoError(err)
+
+		ginkgo.By("Creating a Pod with only a CPU Limit set (ratio defaults to 1 and is allowed)")
+		pod := f.NewTestPod("pod-ratio-limit-only", v1.ResourceList{}, getResourceList("100m", "", ""))
+		pod, err = f.ClientSet.CoreV1().Pods(f.Namespace.Name).Create(pod)
+		framework.ExpectNoError(err)
+
+		ginkgo.By("Failing to create a Pod whose CPU request/limit ratio exceeds MaxLimitRequestRatio")
+		pod = f.NewTestPod("pod-ratio-cpu-violation", getResourceList("100m", "", ""), getResourceList("300m", "", ""))
+		pod, err = f.ClientSet.CoreV1().Pods(f.Namespace.Name).Create(pod)
+		framework.ExpectError(err)
+		if !strings.Contains(err.Error(), string(v1.ResourceCPU)) {
+			e2elog.Failf("expected admission error to identify %q as the offending resource, got: %v", v1.ResourceCPU, err)
+		}
+
+		ginkgo.By("Failing to create a Pod whose DefaultRequest combined with an explicit memory Limit exceeds MaxLimitRequestRatio")
+		limitRange.Spec.Limits[0].DefaultRequest = getResourceList("", "100Mi", "")
+		limitRange, err = f.ClientSet.CoreV1().LimitRanges(f.Namespace.Name).Update(limitRange)
+		framework.ExpectNoError(err)
+		err = wait.Poll(time.Second*2, time.Second*20, func() (bool, error) {
+			limitRange, err = f.ClientSet.CoreV1().LimitRanges(f.Namespace.Name).Get(limitRange.Name, metav1.GetOptions{})
+			framework.ExpectNoError(err)
+			return reflect.DeepEqual(limitRange.Spec.Limits[0].DefaultRequest, getResourceList("", "100Mi", "")), nil
+		})
+		framework.ExpectNoError(err)
+		pod = f.NewTestPod("pod-ratio-memory-violation", v1.ResourceList{}, getResourceList("", "500Mi", ""))
+		pod, err = f.ClientSet.CoreV1().Pods(f.Namespace.Name).Create(pod)
+		framework.ExpectError(err)
+		if !strings.Contains(err.Error(), string(v1.ResourceMemory)) {
+			e2elog.Failf("expected admission error to identify %q as the offending resource, got: %v", v1.ResourceMemory, err)
+		}
+
+		ginkgo.By("Creating a Pod whose ephemeral-storage has no ratio configured and is therefore unconstrained")
+		// CPU and memory are given as limits only, so their requests default to the limits (ratio 1).
+		pod = f.NewTestPod("pod-ratio-storage-unconstrained", getResourceList("", "", "50Gi"), getResourceList("100m", "100Mi", "500Gi"))
+		pod, err = f.ClientSet.CoreV1().Pods(f.Namespace.Name).Create(pod)
+		framework.ExpectNoError(err)
+	})
+
+	// LimitTypePersistentVolumeClaim storage min/max enforcement already
+	// exists in the LimitRange admission plugin; this adds e2e coverage for
+	// it without touching any admission wiring.
+	ginkgo.It("should enforce min/max storage size on PersistentVolumeClaims", func() {
+		ginkgo.By("Creating a LimitRange with a PersistentVolumeClaim storage min and max")
+		min := getStorageResourceList("1Gi")
+		max := getStorageResourceList("10Gi")
+		limitRange := newLimitRange("limit-range-pvc-storage", v1.LimitTypePersistentVolumeClaim,
+			min, max,
+			v1.ResourceList{}, v1.ResourceList{},
+			v1.ResourceList{})
+		limitRange, err := f.ClientSet.CoreV1().LimitRanges(f.Namespace.Name).Create(limitRange)
+		framework.ExpectNoError(err)
+
+		ginkgo.By("Failing to create a PersistentVolumeClaim below the min storage size")
+		pvc := newTestPersistentVolumeClaim("pvc-below-min", "500Mi")
+		_, err = f.ClientSet.CoreV1().PersistentVolumeClaims(f.Namespace.Name).Create(pvc)
+		framework.ExpectError(err)
+
+		ginkgo.By("Failing to create a PersistentVolumeClaim above the max storage size")
+		pvc = newTestPersistentVolumeClaim("pvc-above-max", "20Gi")
+		_, err = f.ClientSet.CoreV1().PersistentVolumeClaims(f.Namespace.Name).Create(pvc)
+		framework.ExpectError(err)
+
+		ginkgo.By("Creating a PersistentVolumeClaim within the min/max storage range")
+		pvc = newTestPersistentVolumeClaim("pvc-within-range", "5Gi")
+		pvc, err = f.ClientSet.CoreV1().PersistentVolumeClaims(f.Namespace.Name).Create(pvc)
+		framework.ExpectNoError(err)
+
+		ginkgo.By("Cleaning up the LimitRange")
+		err = f.ClientSet.CoreV1().LimitRanges(f.Namespace.Name).Delete(limitRange.Name, metav1.NewDeleteOptions(30))
+		framework.ExpectNoError(err)
+	})
+
 })
 
 func equalResourceRequirement(expected v1.ResourceRequirements, actual v1.ResourceRequirements) error {
@@ -240,6 +330,32 @@ func getResourceList(cpu, memory string, ephemeralStorage string) v1.ResourceLis
 	return res
 }
 
+// getStorageResourceList returns a ResourceList populated with a storage
+// quantity, for use with LimitTypePersistentVolumeClaim LimitRange items.
+func getStorageResourceList(storage string) v1.ResourceList {
+	res := v1.ResourceList{}
+	if storage != "" {
+		res[v1.ResourceStorage] = resource.MustParse(storage)
+	}
+	return res
+}
+
+// newTestPersistentVolumeClaim returns a PersistentVolumeClaim requesting the
+// given amount of storage.
+func newTestPersistentVolumeClaim(name string, storage string) *v1.PersistentVolumeClaim {
+	return &v1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: v1.PersistentVolumeClaimSpec{
+			AccessModes: []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce},
+			Resources: v1.ResourceRequirements{
+				Requests: getStorageResourceList(storage),
+			},
+		},
+	}
+}
+
 // newLimitRange returns a limit range with specified data
 func newLimitRange(name string, limitType v1.LimitType,
 	min, max,